@@ -10,9 +10,12 @@ type Numeric interface {
 }
 
 // ElementIndex returns the index of the first occurrence of v in s, or -1 if not present.
+// The loop indexes s[i] instead of ranging over its values, so no copy of E is made while
+// scanning: callers passing large comparable types (byte arrays, big value structs used as
+// keys) can rely on the scan cost being independent of sizeof(E) beyond the comparison itself.
 func ElementIndex[E comparable](s []E, v E) int {
-	for i, vs := range s {
-		if v == vs {
+	for i := range s {
+		if s[i] == v {
 			return i
 		}
 	}
@@ -24,6 +27,24 @@ func InArray[E comparable](s []E, v E) bool {
 	return ElementIndex(s, v) >= 0
 }
 
+// IndexFunc returns the index of the first element in s for which pred returns true, or -1 if
+// none matches. Like ElementIndex, it indexes s[i] rather than ranging over values, so the loop
+// itself makes no per-element copy; pred still receives each element it is called with by value.
+func IndexFunc[E any](s []E, pred func(E) bool) int {
+	for i := range s {
+		if pred(s[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ContainsFunc checks if any element of s satisfies pred. Function acts as a predicate-based
+// counterpart to InArray for types that are not comparable.
+func ContainsFunc[E any](s []E, pred func(E) bool) bool {
+	return IndexFunc(s, pred) >= 0
+}
+
 // ArrayMap returns a modified copy of the slice passed as an argument
 // https://golangprojectstructure.com/functional-programming-with-slices/
 func ArrayMap[Tin, Tout any](slice []Tin, callback func(value Tin, index int) Tout) []Tout {
@@ -51,6 +72,91 @@ func ArrayUnique[T TypeScalar](slice []T) []T {
 	return filteredResult
 }
 
+// ArrayChunk splits slice into consecutive chunks of at most size elements each; the last
+// chunk holds the remainder and may be shorter than size. It panics if size <= 0. Each
+// returned chunk is an independent copy, not a sub-slice of slice's backing array, so
+// appending to one chunk never affects another.
+func ArrayChunk[T any](slice []T, size int) [][]T {
+	if size <= 0 {
+		panic("array_basics.ArrayChunk: size must be > 0")
+	}
+
+	chunksNum := (len(slice) + size - 1) / size
+	chunks := make([][]T, 0, chunksNum)
+
+	for i := 0; i < len(slice); i += size {
+		end := i + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+
+		chunk := make([]T, end-i)
+		copy(chunk, slice[i:end])
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// ArrayConcat returns a new slice containing slice followed by the elements of each slice in
+// more, in order. The exact total length is known up-front, so the result is allocated once.
+func ArrayConcat[T any](slice []T, more ...[]T) []T {
+	total := len(slice)
+	for _, s := range more {
+		total += len(s)
+	}
+
+	result := make([]T, 0, total)
+	result = append(result, slice...)
+
+	for _, s := range more {
+		result = append(result, s...)
+	}
+
+	return result
+}
+
+// ArrayCompact returns a copy of slice with all zero values of T removed.
+func ArrayCompact[T comparable](slice []T) []T {
+	var zero T
+	result := make([]T, 0, len(slice))
+
+	for i := 0; i < len(slice); i++ {
+		if slice[i] != zero {
+			result = append(result, slice[i])
+		}
+	}
+
+	return result
+}
+
+// ArrayCountBy counts how many elements of slice satisfy pred, which receives each element's
+// index alongside its value.
+func ArrayCountBy[T any](slice []T, pred func(index int, value T) bool) int {
+	count := 0
+
+	for i, v := range slice {
+		if pred(i, v) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ArrayGroupBy partitions slice into a map keyed by keyFn(element), preserving the relative
+// order of elements within each group.
+func ArrayGroupBy[T any, K comparable](slice []T, keyFn func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+
+	for _, v := range slice {
+		key := keyFn(v)
+		groups[key] = append(groups[key], v)
+	}
+
+	return groups
+}
+
 // ArrayIntersect returns a slice containing only COMMON values for all specified slices
 func ArrayIntersect[T TypeScalar](slices ...[]T) []T {
 	inSlicesCount := len(slices)
@@ -119,6 +225,149 @@ func ArraySubtract[T TypeScalar](small, big []T) []T {
 	return result
 }
 
+// ArrayIntersectStable is an order-preserving counterpart to ArrayIntersect: it walks the first
+// slice in order and keeps a value the first time it is seen there if that value is also
+// present in every other slice. Unlike ArrayIntersect, the returned slice's element order
+// matches the first input's order and each value appears at most once. Complexity is
+// O(sum of input lengths).
+func ArrayIntersectStable[T comparable](slices ...[]T) []T {
+	if len(slices) == 0 {
+		return nil
+	}
+
+	presentInRest := make([]map[T]struct{}, len(slices)-1)
+	for i, s := range slices[1:] {
+		set := make(map[T]struct{}, len(s))
+		for _, v := range s {
+			set[v] = struct{}{}
+		}
+		presentInRest[i] = set
+	}
+
+	result := make([]T, 0, len(slices[0]))
+	seen := make(map[T]struct{}, len(slices[0]))
+
+	for _, v := range slices[0] {
+		if _, already := seen[v]; already {
+			continue
+		}
+
+		inAll := true
+		for _, set := range presentInRest {
+			if _, present := set[v]; !present {
+				inAll = false
+				break
+			}
+		}
+
+		if inAll {
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// ArrayIntersectFunc is ArrayIntersectStable for values that are not comparable, using keyFn to
+// derive a comparable key for each element. The first slice's element order and its elements
+// are preserved in the result (not the key); each value appears at most once.
+func ArrayIntersectFunc[T any, K comparable](keyFn func(T) K, slices ...[]T) []T {
+	if len(slices) == 0 {
+		return nil
+	}
+
+	presentInRest := make([]map[K]struct{}, len(slices)-1)
+	for i, s := range slices[1:] {
+		set := make(map[K]struct{}, len(s))
+		for _, v := range s {
+			set[keyFn(v)] = struct{}{}
+		}
+		presentInRest[i] = set
+	}
+
+	result := make([]T, 0, len(slices[0]))
+	seen := make(map[K]struct{}, len(slices[0]))
+
+	for _, v := range slices[0] {
+		key := keyFn(v)
+		if _, already := seen[key]; already {
+			continue
+		}
+
+		inAll := true
+		for _, set := range presentInRest {
+			if _, present := set[key]; !present {
+				inAll = false
+				break
+			}
+		}
+
+		if inAll {
+			seen[key] = struct{}{}
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// ArraySubtractStable is an order-preserving counterpart to ArraySubtract: it walks "big" in
+// order and keeps an element the first time it is seen there if it is not present in "small".
+// Unlike ArraySubtract, the returned slice's element order matches "big"'s order and each
+// element appears at most once. Complexity is O(len(small) + len(big)).
+func ArraySubtractStable[T comparable](small, big []T) []T {
+	excluded := make(map[T]struct{}, len(small))
+	for _, v := range small {
+		excluded[v] = struct{}{}
+	}
+
+	result := make([]T, 0, len(big))
+	seen := make(map[T]struct{}, len(big))
+
+	for _, v := range big {
+		if _, present := excluded[v]; present {
+			continue
+		}
+		if _, already := seen[v]; already {
+			continue
+		}
+
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// ArraySubtractFunc is ArraySubtractStable for values that are not comparable, using keyFn to
+// derive a comparable key for each element. "big"'s element order and its elements are
+// preserved in the result (not the key); each value appears at most once.
+func ArraySubtractFunc[T any, K comparable](keyFn func(T) K, small, big []T) []T {
+	excluded := make(map[K]struct{}, len(small))
+	for _, v := range small {
+		excluded[keyFn(v)] = struct{}{}
+	}
+
+	result := make([]T, 0, len(big))
+	seen := make(map[K]struct{}, len(big))
+
+	for _, v := range big {
+		key := keyFn(v)
+		if _, present := excluded[key]; present {
+			continue
+		}
+		if _, already := seen[key]; already {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		result = append(result, v)
+	}
+
+	return result
+}
+
 // IsArrayInArray checks whether an array is a subset of another, larger array.
 // If ALL elements from first array ARE in second array, subset is complete, and function returns TRUE and EMPTY missed array;
 // If there are elements from first array missed in second, function returns FALSE and array with missed elements.
@@ -207,3 +456,15 @@ func FindMedian[N Numeric](data []N) N {
 		return minAboveGuess
 	}
 }
+
+// FindMedianCopy computes the same lower-median value as FindMedian, but takes a sort-based
+// O(n log n) fast path on a private copy of data instead of Torben's algorithm. Callers opt
+// into the copy (and the allocation it implies) explicitly by calling this function instead of
+// FindMedian, which guarantees it never copies or mutates the input.
+func FindMedianCopy[N Numeric](data []N) N {
+	cp := make([]N, len(data))
+	copy(cp, data)
+	Sort(cp)
+
+	return cp[(len(cp)-1)/2]
+}
@@ -0,0 +1,109 @@
+package array_basics
+
+import "testing"
+
+func TestElementIndex(t *testing.T) {
+	cases := []struct {
+		name string
+		s    []int
+		v    int
+		want int
+	}{
+		{"found", []int{10, 20, 30}, 20, 1},
+		{"not found", []int{10, 20, 30}, 99, -1},
+		{"empty", []int{}, 1, -1},
+		{"first duplicate wins", []int{5, 5, 5}, 5, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := ElementIndex(c.s, c.v); got != c.want {
+				t.Errorf("ElementIndex(%v, %v) = %d, want %d", c.s, c.v, got, c.want)
+			}
+		})
+	}
+}
+
+func TestInArray(t *testing.T) {
+	if !InArray([]string{"a", "b"}, "b") {
+		t.Error("InArray should report true for a present element")
+	}
+	if InArray([]string{"a", "b"}, "c") {
+		t.Error("InArray should report false for a missing element")
+	}
+}
+
+func TestIndexFunc(t *testing.T) {
+	s := []int{1, 3, 5, 8, 9}
+	if got := IndexFunc(s, func(v int) bool { return v%2 == 0 }); got != 3 {
+		t.Errorf("IndexFunc = %d, want 3", got)
+	}
+	if got := IndexFunc(s, func(v int) bool { return v > 100 }); got != -1 {
+		t.Errorf("IndexFunc = %d, want -1", got)
+	}
+}
+
+func TestContainsFunc(t *testing.T) {
+	s := []int{1, 3, 5, 8, 9}
+	if !ContainsFunc(s, func(v int) bool { return v%2 == 0 }) {
+		t.Error("ContainsFunc should report true when a predicate match exists")
+	}
+	if ContainsFunc(s, func(v int) bool { return v > 100 }) {
+		t.Error("ContainsFunc should report false when no predicate match exists")
+	}
+}
+
+// largeElement is a stand-in for big comparable value types (wide structs, byte arrays used
+// as keys) where a per-element copy during scanning is expensive enough to show up in a
+// benchmark.
+type largeElement [128]byte
+
+func makeLargeElements(n int) []largeElement {
+	s := make([]largeElement, n)
+	for i := range s {
+		s[i][0] = byte(i)
+	}
+	return s
+}
+
+// elementIndexCopying mirrors the pre-fix implementation (range over values, which copies each
+// largeElement) so the benchmarks below can demonstrate the win from indexing s[i] instead.
+func elementIndexCopying[E comparable](s []E, v E) int {
+	for i, vs := range s {
+		if v == vs {
+			return i
+		}
+	}
+	return -1
+}
+
+func BenchmarkElementIndex_LargeStruct(b *testing.B) {
+	s := makeLargeElements(10000)
+	target := s[len(s)-1]
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ElementIndex(s, target)
+	}
+}
+
+func BenchmarkElementIndexCopying_LargeStruct(b *testing.B) {
+	s := makeLargeElements(10000)
+	target := s[len(s)-1]
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		elementIndexCopying(s, target)
+	}
+}
+
+func BenchmarkIndexFunc_LargeStruct(b *testing.B) {
+	s := makeLargeElements(10000)
+	target := s[len(s)-1]
+	pred := func(v largeElement) bool { return v == target }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		IndexFunc(s, pred)
+	}
+}
@@ -0,0 +1,156 @@
+package array_basics
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArrayIntersectStable(t *testing.T) {
+	cases := []struct {
+		name   string
+		slices [][]int
+		want   []int
+	}{
+		{
+			name:   "no slices",
+			slices: nil,
+			want:   nil,
+		},
+		{
+			name:   "multi-slice intersect",
+			slices: [][]int{{1, 2, 3, 4}, {2, 3, 4, 5}, {3, 4, 5, 6}},
+			want:   []int{3, 4},
+		},
+		{
+			name:   "dedups repeats in the first slice",
+			slices: [][]int{{1, 1, 2, 1, 3}, {1, 2, 3}},
+			want:   []int{1, 2, 3},
+		},
+		{
+			name:   "preserves the first slice's order, not the others'",
+			slices: [][]int{{5, 3, 1}, {1, 3, 5}},
+			want:   []int{5, 3, 1},
+		},
+		{
+			name:   "single slice returns it deduped",
+			slices: [][]int{{1, 1, 2}},
+			want:   []int{1, 2},
+		},
+		{
+			name:   "no common elements",
+			slices: [][]int{{1, 2}, {3, 4}},
+			want:   []int{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := ArrayIntersectStable(c.slices...)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("ArrayIntersectStable(%v) = %v, want %v", c.slices, got, c.want)
+			}
+		})
+	}
+}
+
+func TestArraySubtractStable(t *testing.T) {
+	cases := []struct {
+		name  string
+		small []int
+		big   []int
+		want  []int
+	}{
+		{
+			name:  "removes small's elements, preserves big's order",
+			small: []int{2, 4},
+			big:   []int{1, 2, 3, 4, 5},
+			want:  []int{1, 3, 5},
+		},
+		{
+			name:  "dedups repeats in big",
+			small: nil,
+			big:   []int{1, 1, 2, 1},
+			want:  []int{1, 2},
+		},
+		{
+			name:  "empty small returns big deduped",
+			small: []int{},
+			big:   []int{3, 3, 1, 2},
+			want:  []int{3, 1, 2},
+		},
+		{
+			name:  "empty big returns empty",
+			small: []int{1, 2},
+			big:   []int{},
+			want:  []int{},
+		},
+		{
+			name:  "small bigger than big",
+			small: []int{1, 2, 3, 4, 5},
+			big:   []int{2, 4},
+			want:  []int{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := ArraySubtractStable(c.small, c.big)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("ArraySubtractStable(%v, %v) = %v, want %v", c.small, c.big, got, c.want)
+			}
+		})
+	}
+}
+
+// labeledPoint is a non-comparable-by-value-we-care-about struct (only Key matters for
+// identity) used to exercise the *Func key-based variants.
+type labeledPoint struct {
+	Key   string
+	Value int
+}
+
+func TestArrayIntersectFunc(t *testing.T) {
+	keyFn := func(p labeledPoint) string { return p.Key }
+
+	a := []labeledPoint{{"x", 1}, {"y", 2}, {"x", 3}, {"z", 4}}
+	b := []labeledPoint{{"x", 100}, {"z", 200}}
+
+	got := ArrayIntersectFunc(keyFn, a, b)
+	want := []labeledPoint{{"x", 1}, {"z", 4}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ArrayIntersectFunc = %v, want %v", got, want)
+	}
+}
+
+func TestArrayIntersectFuncNoSlices(t *testing.T) {
+	keyFn := func(p labeledPoint) string { return p.Key }
+
+	got := ArrayIntersectFunc[labeledPoint, string](keyFn)
+	if got != nil {
+		t.Errorf("ArrayIntersectFunc() with no slices = %v, want nil", got)
+	}
+}
+
+func TestArraySubtractFunc(t *testing.T) {
+	keyFn := func(p labeledPoint) string { return p.Key }
+
+	small := []labeledPoint{{"x", 100}}
+	big := []labeledPoint{{"x", 1}, {"y", 2}, {"x", 3}, {"z", 4}}
+
+	got := ArraySubtractFunc(keyFn, small, big)
+	want := []labeledPoint{{"y", 2}, {"z", 4}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ArraySubtractFunc = %v, want %v", got, want)
+	}
+}
+
+func TestArraySubtractFuncEmptyBig(t *testing.T) {
+	keyFn := func(p labeledPoint) string { return p.Key }
+
+	got := ArraySubtractFunc(keyFn, []labeledPoint{{"x", 1}}, []labeledPoint{})
+	if len(got) != 0 {
+		t.Errorf("ArraySubtractFunc with empty big = %v, want empty", got)
+	}
+}
@@ -0,0 +1,128 @@
+package array_basics
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArrayChunk(t *testing.T) {
+	cases := []struct {
+		name string
+		s    []int
+		size int
+		want [][]int
+	}{
+		{"even split", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"short last chunk", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"size bigger than slice", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"empty input", []int{}, 3, [][]int{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := ArrayChunk(c.s, c.size)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("ArrayChunk(%v, %d) = %v, want %v", c.s, c.size, got, c.want)
+			}
+		})
+	}
+}
+
+func TestArrayChunkPanicsOnNonPositiveSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("ArrayChunk(size=%d) should panic", size)
+				}
+			}()
+			ArrayChunk([]int{1, 2, 3}, size)
+		}()
+	}
+}
+
+func TestArrayChunkChunksAreIndependent(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6}
+	chunks := ArrayChunk(s, 2)
+
+	chunks[0] = append(chunks[0], 999)
+
+	if chunks[1][0] != 3 {
+		t.Errorf("appending to chunks[0] affected chunks[1]: got %v", chunks[1])
+	}
+}
+
+func TestArrayConcat(t *testing.T) {
+	cases := []struct {
+		name string
+		s    []int
+		more [][]int
+		want []int
+	}{
+		{"no extra slices", []int{1, 2}, nil, []int{1, 2}},
+		{"one extra slice", []int{1, 2}, [][]int{{3, 4}}, []int{1, 2, 3, 4}},
+		{"several extra slices", []int{1}, [][]int{{2, 3}, {}, {4}}, []int{1, 2, 3, 4}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := ArrayConcat(c.s, c.more...)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("ArrayConcat(%v, %v) = %v, want %v", c.s, c.more, got, c.want)
+			}
+		})
+	}
+}
+
+func TestArrayCompact(t *testing.T) {
+	cases := []struct {
+		name string
+		s    []int
+		want []int
+	}{
+		{"drops zeros", []int{0, 1, 0, 2, 0}, []int{1, 2}},
+		{"no zeros", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"all zeros", []int{0, 0}, []int{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := ArrayCompact(c.s)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("ArrayCompact(%v) = %v, want %v", c.s, got, c.want)
+			}
+		})
+	}
+}
+
+func TestArrayCountBy(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	got := ArrayCountBy(s, func(i, v int) bool { return v%2 == 0 })
+	if got != 2 {
+		t.Errorf("ArrayCountBy(evens) = %d, want 2", got)
+	}
+
+	got = ArrayCountBy(s, func(i, v int) bool { return i == 0 })
+	if got != 1 {
+		t.Errorf("ArrayCountBy(index==0) = %d, want 1", got)
+	}
+}
+
+func TestArrayGroupBy(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6}
+	got := ArrayGroupBy(s, func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+
+	want := map[string][]int{
+		"odd":  {1, 3, 5},
+		"even": {2, 4, 6},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ArrayGroupBy(%v) = %v, want %v", s, got, want)
+	}
+}
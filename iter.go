@@ -0,0 +1,73 @@
+package array_basics
+
+import "iter"
+
+// All returns a push iterator over index-value pairs of s, in order, for use with Go's
+// range-over-func (`for i, v := range array_basics.All(s)`). It lets callers chain
+// array_basics operations without materializing intermediate slices, the same way ArrayMap
+// already encourages pipeline-style code.
+func All[T any](s []T) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range s {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a push iterator over the values of s, in order.
+func Values[T any](s []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a push iterator over index-value pairs of s in reverse order.
+func Backward[T any](s []T) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(s) - 1; i >= 0; i-- {
+			if !yield(i, s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// MapIter lazily applies f to each value produced by seq, without materializing the
+// intermediate sequence.
+func MapIter[Tin, Tout any](seq iter.Seq[Tin], f func(Tin) Tout) iter.Seq[Tout] {
+	return func(yield func(Tout) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterIter lazily yields only the values from seq for which pred returns true.
+func FilterIter[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect drains seq into a new slice, in iteration order. It is the terminal operation that
+// ends a chain of MapIter/FilterIter calls.
+func Collect[T any](seq iter.Seq[T]) []T {
+	var result []T
+	for v := range seq {
+		result = append(result, v)
+	}
+
+	return result
+}
@@ -0,0 +1,176 @@
+package array_basics
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	s := []string{"a", "b", "c"}
+
+	var gotIdx []int
+	var gotVal []string
+	for i, v := range All(s) {
+		gotIdx = append(gotIdx, i)
+		gotVal = append(gotVal, v)
+	}
+
+	if !reflect.DeepEqual(gotIdx, []int{0, 1, 2}) || !reflect.DeepEqual(gotVal, s) {
+		t.Errorf("All(%v) yielded indices %v values %v", s, gotIdx, gotVal)
+	}
+}
+
+func TestAllStopsEarly(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+
+	var got []int
+	for i, v := range All(s) {
+		if i == 2 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("All(%v) with early break yielded %v, want [1 2]", s, got)
+	}
+}
+
+func TestAllEmpty(t *testing.T) {
+	count := 0
+	for range All([]int{}) {
+		count++
+	}
+	if count != 0 {
+		t.Errorf("All(empty) yielded %d times, want 0", count)
+	}
+}
+
+func TestValues(t *testing.T) {
+	s := []int{10, 20, 30}
+
+	var got []int
+	for v := range Values(s) {
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, s) {
+		t.Errorf("Values(%v) yielded %v", s, got)
+	}
+}
+
+func TestValuesStopsEarly(t *testing.T) {
+	s := []int{10, 20, 30, 40}
+
+	var got []int
+	for v := range Values(s) {
+		if v == 30 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, []int{10, 20}) {
+		t.Errorf("Values(%v) with early break yielded %v, want [10 20]", s, got)
+	}
+}
+
+func TestBackward(t *testing.T) {
+	s := []string{"a", "b", "c"}
+
+	var gotIdx []int
+	var gotVal []string
+	for i, v := range Backward(s) {
+		gotIdx = append(gotIdx, i)
+		gotVal = append(gotVal, v)
+	}
+
+	if !reflect.DeepEqual(gotIdx, []int{2, 1, 0}) || !reflect.DeepEqual(gotVal, []string{"c", "b", "a"}) {
+		t.Errorf("Backward(%v) yielded indices %v values %v", s, gotIdx, gotVal)
+	}
+}
+
+func TestBackwardEmpty(t *testing.T) {
+	count := 0
+	for range Backward([]int{}) {
+		count++
+	}
+	if count != 0 {
+		t.Errorf("Backward(empty) yielded %d times, want 0", count)
+	}
+}
+
+func TestMapIter(t *testing.T) {
+	s := []int{1, 2, 3}
+
+	doubled := MapIter(Values(s), func(v int) int { return v * 2 })
+
+	var got []int
+	for v := range doubled {
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, []int{2, 4, 6}) {
+		t.Errorf("MapIter doubled %v, want [2 4 6]", got)
+	}
+}
+
+func TestMapIterStopsEarly(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+
+	doubled := MapIter(Values(s), func(v int) int { return v * 2 })
+
+	var got []int
+	for v := range doubled {
+		if v == 6 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("MapIter with early break yielded %v, want [2 4]", got)
+	}
+}
+
+func TestFilterIter(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6}
+
+	evens := FilterIter(Values(s), func(v int) bool { return v%2 == 0 })
+
+	var got []int
+	for v := range evens {
+		got = append(got, v)
+	}
+
+	if !reflect.DeepEqual(got, []int{2, 4, 6}) {
+		t.Errorf("FilterIter evens = %v, want [2 4 6]", got)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	s := []int{1, 2, 3}
+
+	got := Collect(Values(s))
+	if !reflect.DeepEqual(got, s) {
+		t.Errorf("Collect(Values(%v)) = %v, want %v", s, got, s)
+	}
+}
+
+func TestCollectEmpty(t *testing.T) {
+	got := Collect(Values([]int{}))
+	if len(got) != 0 {
+		t.Errorf("Collect(Values(empty)) = %v, want empty", got)
+	}
+}
+
+func TestCollectMapIterFilterIterChain(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	got := Collect(FilterIter(MapIter(Values(s), func(v int) int { return v * v }), func(v int) bool { return v%2 == 0 }))
+	want := []int{4, 16, 36, 64}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Collect(FilterIter(MapIter(...))) = %v, want %v", got, want)
+	}
+}
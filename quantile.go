@@ -0,0 +1,192 @@
+package array_basics
+
+import "sort"
+
+// QuantileEstimator estimates a single quantile (0 < q < 1) of a stream of N values using
+// Jain & Chlamtac's P² algorithm (https://doi.org/10.1145/4372.4378). It keeps 5 markers -
+// the running min, the running max, and three interior markers that track their ideal
+// ("desired") position in the sorted stream - and, after each new sample, moves any interior
+// marker whose actual position has drifted at least one step from its desired position by one
+// step, updating its height with a piecewise-parabolic estimate. Memory use is constant
+// (a handful of float64s) and Add never allocates once the first 5 samples have been seen, so
+// this is meant for streams too large to buffer for FindMedian or FindMedianCopy.
+type QuantileEstimator[N Numeric] struct {
+	q float64
+
+	// initial buffers the first 5 samples, needed to seed the markers; unused afterwards.
+	initial []float64
+
+	initialized bool
+	heights     [5]float64 // q[i]: current height (value) estimate at each marker
+	actualPos   [5]float64 // n[i]: actual marker position (integer-valued) within the stream
+	desiredPos  [5]float64 // n'[i]: ideal marker position, grows by increments[i] per sample
+	increments  [5]float64 // dn[i]: how much n'[i] grows per sample
+}
+
+// NewQuantileEstimator returns an estimator for the q-th quantile (0 < q < 1) of a value
+// stream; it panics if q is out of range.
+func NewQuantileEstimator[N Numeric](q float64) *QuantileEstimator[N] {
+	if q <= 0 || q >= 1 {
+		panic("array_basics.NewQuantileEstimator: q must be in (0, 1)")
+	}
+
+	return &QuantileEstimator[N]{
+		q:       q,
+		initial: make([]float64, 0, 5),
+	}
+}
+
+// NewMedianEstimator is a convenience constructor for NewQuantileEstimator[N](0.5).
+func NewMedianEstimator[N Numeric]() *QuantileEstimator[N] {
+	return NewQuantileEstimator[N](0.5)
+}
+
+// Add feeds one more sample from the stream into the estimator.
+func (e *QuantileEstimator[N]) Add(x N) {
+	xf := float64(x)
+
+	if !e.initialized {
+		e.initial = append(e.initial, xf)
+		if len(e.initial) < 5 {
+			return
+		}
+
+		sort.Float64s(e.initial)
+		copy(e.heights[:], e.initial)
+
+		q := e.q
+		e.actualPos = [5]float64{1, 2, 3, 4, 5}
+		e.desiredPos = [5]float64{1, 1 + 2*q, 1 + 4*q, 3 + 2*q, 5}
+		e.increments = [5]float64{0, q / 2, q, (1 + q) / 2, 1}
+		e.initialized = true
+
+		return
+	}
+
+	k := e.cell(xf)
+
+	for i := k + 1; i < 5; i++ {
+		e.actualPos[i]++
+	}
+	for i := 0; i < 5; i++ {
+		e.desiredPos[i] += e.increments[i]
+	}
+
+	for i := 1; i <= 3; i++ {
+		d := e.desiredPos[i] - e.actualPos[i]
+
+		if d >= 1 && e.actualPos[i+1]-e.actualPos[i] > 1 {
+			e.adjust(i, 1)
+		} else if d <= -1 && e.actualPos[i-1]-e.actualPos[i] < -1 {
+			e.adjust(i, -1)
+		}
+	}
+}
+
+// cell finds which of the 5 cells x falls into, updating the extreme markers in place when x
+// is a new min or max, and returns the index k such that x belongs between markers k and k+1.
+func (e *QuantileEstimator[N]) cell(x float64) int {
+	switch {
+	case x < e.heights[0]:
+		e.heights[0] = x
+		return 0
+	case x >= e.heights[4]:
+		e.heights[4] = x
+		return 3
+	default:
+		for i := 1; i < 5; i++ {
+			if x < e.heights[i] {
+				return i - 1
+			}
+		}
+		return 3
+	}
+}
+
+// adjust moves marker i by sign (+1 or -1), preferring the piecewise-parabolic height estimate
+// and falling back to linear interpolation when the parabolic result would not stay strictly
+// between the marker's neighbors.
+func (e *QuantileEstimator[N]) adjust(i int, sign float64) {
+	parabolic := e.heights[i] + sign/(e.actualPos[i+1]-e.actualPos[i-1])*((e.actualPos[i]-e.actualPos[i-1]+sign)*(e.heights[i+1]-e.heights[i])/(e.actualPos[i+1]-e.actualPos[i])+
+		(e.actualPos[i+1]-e.actualPos[i]-sign)*(e.heights[i]-e.heights[i-1])/(e.actualPos[i]-e.actualPos[i-1]))
+
+	if e.heights[i-1] < parabolic && parabolic < e.heights[i+1] {
+		e.heights[i] = parabolic
+	} else {
+		j := i + int(sign)
+		e.heights[i] += sign * (e.heights[j] - e.heights[i]) / (e.actualPos[j] - e.actualPos[i])
+	}
+
+	e.actualPos[i] += sign
+}
+
+// Value returns the current estimate of the configured quantile. Before 5 samples have been
+// seen the markers haven't been initialized yet, so Value falls back to the nearest-rank
+// element of whatever has been added so far (returning the zero value of N if nothing has).
+func (e *QuantileEstimator[N]) Value() N {
+	if !e.initialized {
+		if len(e.initial) == 0 {
+			var zero N
+			return zero
+		}
+
+		sorted := append([]float64(nil), e.initial...)
+		sort.Float64s(sorted)
+		idx := int(e.q*float64(len(sorted)-1) + 0.5)
+
+		return N(sorted[idx])
+	}
+
+	return N(e.heights[2])
+}
+
+// MultiQuantile tracks several quantiles of the same stream at once by running one
+// QuantileEstimator per quantile in lock-step.
+type MultiQuantile[N Numeric] struct {
+	qs         []float64
+	estimators []*QuantileEstimator[N]
+}
+
+// NewMultiQuantile returns a MultiQuantile tracking each quantile in qs (every value must be in
+// (0, 1)).
+func NewMultiQuantile[N Numeric](qs ...float64) *MultiQuantile[N] {
+	estimators := make([]*QuantileEstimator[N], len(qs))
+	for i, q := range qs {
+		estimators[i] = NewQuantileEstimator[N](q)
+	}
+
+	return &MultiQuantile[N]{
+		qs:         append([]float64(nil), qs...),
+		estimators: estimators,
+	}
+}
+
+// Add feeds one more sample from the stream into every tracked quantile.
+func (m *MultiQuantile[N]) Add(x N) {
+	for _, e := range m.estimators {
+		e.Add(x)
+	}
+}
+
+// Value returns the current estimate for quantile q, and whether q is one of the quantiles
+// this MultiQuantile was constructed with.
+func (m *MultiQuantile[N]) Value(q float64) (N, bool) {
+	for i, tracked := range m.qs {
+		if tracked == q {
+			return m.estimators[i].Value(), true
+		}
+	}
+
+	var zero N
+	return zero, false
+}
+
+// Values returns the current estimate for every tracked quantile, keyed by quantile.
+func (m *MultiQuantile[N]) Values() map[float64]N {
+	out := make(map[float64]N, len(m.qs))
+	for i, q := range m.qs {
+		out[q] = m.estimators[i].Value()
+	}
+
+	return out
+}
@@ -0,0 +1,134 @@
+package array_basics
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestQuantileEstimatorApproximatesMedian(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	n := 20000
+	data := make([]float64, n)
+	for i := range data {
+		data[i] = r.Float64() * 1000
+	}
+
+	est := NewMedianEstimator[float64]()
+	for _, x := range data {
+		est.Add(x)
+	}
+
+	sorted := append([]float64(nil), data...)
+	sort.Float64s(sorted)
+	trueMedian := sorted[n/2]
+
+	got := est.Value()
+	diff := got - trueMedian
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > trueMedian*0.05 {
+		t.Errorf("median estimate %v too far from true median %v", got, trueMedian)
+	}
+}
+
+func TestQuantileEstimatorApproximatesP90(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	n := 20000
+	data := make([]float64, n)
+	for i := range data {
+		data[i] = r.Float64() * 1000
+	}
+
+	est := NewQuantileEstimator[float64](0.9)
+	for _, x := range data {
+		est.Add(x)
+	}
+
+	sorted := append([]float64(nil), data...)
+	sort.Float64s(sorted)
+	truth := sorted[int(0.9*float64(n))]
+
+	got := est.Value()
+	diff := got - truth
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > truth*0.05 {
+		t.Errorf("p90 estimate %v too far from true p90 %v", got, truth)
+	}
+}
+
+func TestQuantileEstimatorFewSamples(t *testing.T) {
+	est := NewMedianEstimator[int]()
+	if got := est.Value(); got != 0 {
+		t.Errorf("Value() on empty estimator = %d, want 0", got)
+	}
+
+	est.Add(10)
+	est.Add(30)
+	est.Add(20)
+
+	// Fewer than 5 samples: Value falls back to the nearest-rank element of what's been seen.
+	if got := est.Value(); got != 20 {
+		t.Errorf("Value() with 3 samples = %d, want 20", got)
+	}
+}
+
+func TestQuantileEstimatorPanicsOnInvalidQuantile(t *testing.T) {
+	for _, q := range []float64{0, 1, -0.1, 1.1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewQuantileEstimator(%v) should panic", q)
+				}
+			}()
+			NewQuantileEstimator[float64](q)
+		}()
+	}
+}
+
+func TestMultiQuantile(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+
+	n := 20000
+	data := make([]float64, n)
+	for i := range data {
+		data[i] = r.Float64() * 1000
+	}
+
+	mq := NewMultiQuantile[float64](0.5, 0.9)
+	for _, x := range data {
+		mq.Add(x)
+	}
+
+	sorted := append([]float64(nil), data...)
+	sort.Float64s(sorted)
+
+	median, ok := mq.Value(0.5)
+	if !ok {
+		t.Fatal("Value(0.5) reported not tracked")
+	}
+	if diff := abs(median - sorted[n/2]); diff > sorted[n/2]*0.05 {
+		t.Errorf("median estimate %v too far from true median %v", median, sorted[n/2])
+	}
+
+	if _, ok := mq.Value(0.99); ok {
+		t.Error("Value(0.99) should report not tracked")
+	}
+
+	values := mq.Values()
+	if len(values) != 2 {
+		t.Errorf("Values() returned %d entries, want 2", len(values))
+	}
+}
+
+func abs(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
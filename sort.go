@@ -0,0 +1,398 @@
+package array_basics
+
+// Sort subsystem: SortFunc, SortStableFunc, IsSorted, IsSortedFunc, BinarySearch and
+// BinarySearchFunc, parallel to the generic sorting helpers in golang.org/x/exp/slices.
+
+// insertionSortThreshold is the range length at or below which plain insertion sort beats a
+// partitioning scheme.
+const insertionSortThreshold = 12
+
+// Sort sorts a slice of Numeric values in ascending order. It is a thin convenience wrapper
+// around SortFunc for the common case where a natural `<` ordering is enough.
+func Sort[T Numeric](s []T) {
+	SortFunc(s, numericCmp[T])
+}
+
+// IsSorted reports whether s is sorted in ascending order.
+func IsSorted[T Numeric](s []T) bool {
+	return IsSortedFunc(s, numericCmp[T])
+}
+
+func numericCmp[T Numeric](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsSortedFunc reports whether s is sorted according to cmp: cmp(a, b) must be negative when a
+// belongs before b, positive when after, and zero when they are equivalent.
+func IsSortedFunc[T any](s []T, cmp func(a, b T) int) bool {
+	for i := len(s) - 1; i > 0; i-- {
+		if cmp(s[i], s[i-1]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SortFunc sorts s in ascending order as determined by cmp(a, b): negative when a should sort
+// before b, positive when after, zero when equivalent. The relative order of equal elements is
+// not guaranteed; use SortStableFunc when that matters.
+//
+// SortFunc implements pattern-defeating quicksort (pdqsort, see Orson Peters'
+// https://arxiv.org/abs/2106.05123): plain insertion sort at or below insertionSortThreshold
+// elements, median-of-three pivot selection on small ranges and a ninther
+// (median-of-medians-of-three) on larger ones, a partial-insertion-sort shortcut that bails out
+// early on already-mostly-sorted input, and a heapsort fallback once the recursion budget of
+// 2*log2(n) partitions is exhausted, so adversarial inputs still finish in O(n log n).
+func SortFunc[T any](s []T, cmp func(a, b T) int) {
+	if len(s) < 2 {
+		return
+	}
+
+	limit := 0
+	for n := len(s); n > 0; n >>= 1 {
+		limit++
+	}
+
+	pdqsort(s, cmp, limit*2)
+}
+
+// pdqsort sorts s[0:len(s)] in place, recursing on the smaller of the two partitions produced
+// at each step and looping on the larger one (classic tail-recursion elimination), so the call
+// stack stays O(log n) even though the loop handles arbitrarily large ranges.
+func pdqsort[T any](s []T, cmp func(a, b T) int, limit int) {
+	for {
+		n := len(s)
+
+		if n <= insertionSortThreshold {
+			insertionSort(s, cmp)
+			return
+		}
+
+		if limit == 0 {
+			heapsort(s, cmp)
+			return
+		}
+		limit--
+
+		pivot := choosePivot(s, cmp)
+		s[0], s[pivot] = s[pivot], s[0]
+
+		if n > 128 && isMostlySorted(s, cmp) && partialInsertionSort(s, cmp) {
+			return
+		}
+
+		lo, hi := partition(s, cmp)
+
+		// s[lo:hi] all compare equal to the pivot and are already in their final position
+		// relative to everything else, so only the strictly-less and strictly-greater ranges
+		// need further sorting. Skipping the equal range (rather than recursing on it) is what
+		// keeps low-cardinality input - status/enum/category columns with only a handful of
+		// distinct values are common in practice - from degenerating into repeated
+		// near-zero-progress partitions and blowing up to O(n^2).
+		left, right := s[:lo], s[hi:]
+
+		if len(left) < len(right) {
+			pdqsort(left, cmp, limit)
+			s = right
+		} else {
+			pdqsort(right, cmp, limit)
+			s = left
+		}
+	}
+}
+
+// choosePivot picks a pivot index for s using median-of-three on small ranges, or a ninther
+// (median of three medians-of-three spread across the range) on larger ranges, which makes the
+// choice resistant to common adversarial orderings (sorted, reverse-sorted, organ-pipe).
+func choosePivot[T any](s []T, cmp func(a, b T) int) int {
+	n := len(s)
+	mid := n / 2
+
+	if n <= 128 {
+		return medianOfThree(s, cmp, 0, mid, n-1)
+	}
+
+	step := n / 8
+	a := medianOfThree(s, cmp, 0, step, 2*step)
+	b := medianOfThree(s, cmp, mid-step, mid, mid+step)
+	c := medianOfThree(s, cmp, n-1-2*step, n-1-step, n-1)
+
+	return medianOfThree(s, cmp, a, b, c)
+}
+
+// medianOfThree returns whichever of the three indices a, b, c holds the median value,
+// without mutating s.
+func medianOfThree[T any](s []T, cmp func(a, b T) int, a, b, c int) int {
+	if cmp(s[a], s[b]) < 0 {
+		if cmp(s[b], s[c]) < 0 {
+			return b
+		}
+		if cmp(s[a], s[c]) < 0 {
+			return c
+		}
+		return a
+	}
+	if cmp(s[a], s[c]) < 0 {
+		return a
+	}
+	if cmp(s[b], s[c]) < 0 {
+		return c
+	}
+	return b
+}
+
+// partition rearranges s around the pivot held in s[0] (moved there by the caller) into three
+// contiguous ranges: s[:lo] compares strictly less than the pivot, s[lo:hi] compares equal to
+// it, and s[hi:] compares strictly greater. This three-way (Dutch national flag) split lets
+// pdqsort skip the equal range entirely instead of repeatedly re-partitioning around it, which
+// is what bounds the algorithm to O(n log n) even on inputs with many duplicate values.
+func partition[T any](s []T, cmp func(a, b T) int) (lo, hi int) {
+	pivot := s[0]
+	lo, i, hi := 0, 0, len(s)
+
+	for i < hi {
+		switch c := cmp(s[i], pivot); {
+		case c < 0:
+			s[lo], s[i] = s[i], s[lo]
+			lo++
+			i++
+		case c > 0:
+			hi--
+			s[i], s[hi] = s[hi], s[i]
+		default:
+			i++
+		}
+	}
+
+	return lo, hi
+}
+
+// isMostlySorted cheaply samples a handful of pairs spaced len(s)/8 apart to decide whether it's worth
+// attempting the bounded partialInsertionSort shortcut instead of a full partition.
+func isMostlySorted[T any](s []T, cmp func(a, b T) int) bool {
+	const samples = 8
+
+	n := len(s)
+	step := n / samples
+	if step == 0 {
+		step = 1
+	}
+
+	disorder := 0
+	for i := step; i < n; i += step {
+		if cmp(s[i], s[i-step]) < 0 {
+			disorder++
+		}
+	}
+
+	return disorder <= samples/4
+}
+
+// partialInsertionSort tries to finish sorting an already-mostly-sorted s with a bounded
+// number of insertion-sort swaps (proportional to n), bailing out (returning false, s left
+// partially scanned but otherwise untouched in its sorted prefix) the moment that budget is
+// exceeded so pathological inputs can't turn this shortcut into quadratic work.
+func partialInsertionSort[T any](s []T, cmp func(a, b T) int) bool {
+	const maxSwapsFactor = 4
+
+	n := len(s)
+	maxSwaps := maxSwapsFactor * n
+	swaps := 0
+
+	for i := 1; i < n; i++ {
+		if cmp(s[i], s[i-1]) >= 0 {
+			continue
+		}
+
+		j := i
+		for j > 0 && cmp(s[j], s[j-1]) < 0 {
+			s[j], s[j-1] = s[j-1], s[j]
+			j--
+			swaps++
+		}
+
+		if swaps > maxSwaps {
+			return false
+		}
+	}
+
+	return true
+}
+
+// insertionSort sorts s in place; used directly for small ranges and as pdqsort's base case.
+func insertionSort[T any](s []T, cmp func(a, b T) int) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && cmp(s[j], s[j-1]) < 0; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+// heapsort sorts s in place in guaranteed O(n log n) time. It is pdqsort's fallback once the
+// recursion budget is exhausted, which bounds the worst case for inputs that keep defeating the
+// pivot selection.
+func heapsort[T any](s []T, cmp func(a, b T) int) {
+	n := len(s)
+
+	siftDown := func(lo, hi int) {
+		root := lo
+		for {
+			child := 2*root + 1
+			if child > hi {
+				return
+			}
+			if child+1 <= hi && cmp(s[child], s[child+1]) < 0 {
+				child++
+			}
+			if cmp(s[root], s[child]) >= 0 {
+				return
+			}
+			s[root], s[child] = s[child], s[root]
+			root = child
+		}
+	}
+
+	for lo := n/2 - 1; lo >= 0; lo-- {
+		siftDown(lo, n-1)
+	}
+
+	for hi := n - 1; hi > 0; hi-- {
+		s[0], s[hi] = s[hi], s[0]
+		siftDown(0, hi-1)
+	}
+}
+
+// SortStableFunc sorts s in ascending order as determined by cmp, keeping the relative order of
+// elements that compare equal.
+//
+// It runs block insertion sort over fixed-size blocks and then repeatedly merges adjacent
+// blocks with symMerge, an in-place stable merge that uses O(1) extra memory per merge step
+// (it rotates sub-ranges instead of allocating a scratch buffer), doubling the block size each
+// pass until the whole slice has been merged.
+func SortStableFunc[T any](s []T, cmp func(a, b T) int) {
+	n := len(s)
+	blockSize := 20
+
+	a, b := 0, blockSize
+	for b <= n {
+		insertionSort(s[a:b], cmp)
+		a = b
+		b += blockSize
+	}
+	insertionSort(s[a:n], cmp)
+
+	for blockSize < n {
+		a, b = 0, 2*blockSize
+		for b <= n {
+			symMerge(s, cmp, a, a+blockSize, b)
+			a = b
+			b += 2 * blockSize
+		}
+		if m := a + blockSize; m < n {
+			symMerge(s, cmp, a, m, n)
+		}
+		blockSize *= 2
+	}
+}
+
+// symMerge merges the two sorted sub-ranges s[lo:mid] and s[mid:hi] in place, preserving the
+// relative order of equal elements, using only O(1) extra space via a series of rotations.
+func symMerge[T any](s []T, cmp func(a, b T) int, lo, mid, hi int) {
+	if mid-lo == 1 {
+		x := s[lo]
+		i := mid
+		for i < hi && cmp(s[i], x) < 0 {
+			i++
+		}
+		copy(s[lo:i-1], s[lo+1:i])
+		s[i-1] = x
+		return
+	}
+
+	if hi-mid == 1 {
+		x := s[mid]
+		i := mid
+		for i > lo && cmp(x, s[i-1]) < 0 {
+			i--
+		}
+		copy(s[i+1:mid+1], s[i:mid])
+		s[i] = x
+		return
+	}
+
+	m := (lo + hi) / 2
+	n := m + mid
+	var start, r int
+	if mid > m {
+		start, r = n-hi, m
+	} else {
+		start, r = lo, mid
+	}
+	p := n - 1
+
+	for start < r {
+		c := (start + r) / 2
+		if cmp(s[c], s[p-c]) <= 0 {
+			start = c + 1
+		} else {
+			r = c
+		}
+	}
+
+	end := n - start
+	if start < mid && mid < end {
+		rotateRange(s, start, mid, end)
+	}
+	if lo < start && start < m {
+		symMerge(s, cmp, lo, start, m)
+	}
+	if m < end && end < hi {
+		symMerge(s, cmp, m, end, hi)
+	}
+}
+
+// rotateRange swaps the two sub-ranges s[lo:mid] and s[mid:hi] using the standard reverse-
+// reverse-reverse trick, which needs no extra storage.
+func rotateRange[T any](s []T, lo, mid, hi int) {
+	reverseRange(s[lo:mid])
+	reverseRange(s[mid:hi])
+	reverseRange(s[lo:hi])
+}
+
+func reverseRange[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// BinarySearch searches for target in a sorted slice of Numeric values and returns the
+// position where it was found, or where it would be inserted to keep s sorted.
+func BinarySearch[T Numeric](s []T, target T) (int, bool) {
+	return BinarySearchFunc(s, target, numericCmp[T])
+}
+
+// BinarySearchFunc searches s, which must be sorted according to cmp in ascending order, for
+// target and returns the earliest index i such that cmp(s[i], target) >= 0, together with
+// whether s[i] == target (cmp(s[i], target) == 0). If no such index exists, it returns
+// len(s), false.
+func BinarySearchFunc[T any](s []T, target T, cmp func(a, b T) int) (int, bool) {
+	lo, hi := 0, len(s)
+
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if cmp(s[mid], target) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	return lo, lo < len(s) && cmp(s[lo], target) == 0
+}
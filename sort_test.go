@@ -0,0 +1,228 @@
+package array_basics
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func intCmp(a, b int) int { return a - b }
+
+func TestSortFuncRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for trial := 0; trial < 200; trial++ {
+		n := r.Intn(500)
+		s := make([]int, n)
+		for i := range s {
+			s[i] = r.Intn(50) - 25
+		}
+
+		want := append([]int(nil), s...)
+		sort.Ints(want)
+
+		SortFunc(s, intCmp)
+
+		for i := range s {
+			if s[i] != want[i] {
+				t.Fatalf("trial %d: mismatch at %d: got %v, want %v", trial, i, s, want)
+			}
+		}
+		if !IsSortedFunc(s, intCmp) {
+			t.Fatalf("trial %d: IsSortedFunc reports false on sorted output", trial)
+		}
+	}
+}
+
+func TestSortFuncEdgeCases(t *testing.T) {
+	cases := [][]int{
+		{},
+		{1},
+		{2, 1},
+		{1, 1, 1, 1},
+		{5, 4, 3, 2, 1},
+	}
+
+	for _, s := range cases {
+		input := append([]int(nil), s...)
+		want := append([]int(nil), s...)
+		sort.Ints(want)
+
+		SortFunc(s, intCmp)
+
+		for i := range s {
+			if s[i] != want[i] {
+				t.Fatalf("mismatch for input %v: got %v, want %v", input, s, want)
+			}
+		}
+	}
+}
+
+// TestSortFuncLowCardinalityIsLinearithmic reproduces the regression where a 2-way partition
+// degenerated into O(n^2) work on inputs with very few distinct values (e.g. shuffled
+// status/enum columns). It asserts both correctness and that sorting finishes well within
+// O(n log n) territory rather than timing out.
+func TestSortFuncLowCardinalityIsLinearithmic(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+
+	n := 100000
+	s := make([]int, n)
+	for i := range s {
+		s[i] = r.Intn(4)
+	}
+
+	want := append([]int(nil), s...)
+	sort.Ints(want)
+
+	start := time.Now()
+	SortFunc(s, intCmp)
+	elapsed := time.Since(start)
+
+	for i := range s {
+		if s[i] != want[i] {
+			t.Fatalf("mismatch at %d: got %v, want %v", i, s[i], want[i])
+		}
+	}
+
+	if elapsed > 500*time.Millisecond {
+		t.Fatalf("low-cardinality sort took %v, want well under 500ms (suspect O(n^2) regression)", elapsed)
+	}
+}
+
+// TestSortFuncPeriodicIsLinearithmic covers the other reported blowup case: a periodic,
+// few-valued slice rather than a randomly shuffled one.
+func TestSortFuncPeriodicIsLinearithmic(t *testing.T) {
+	n := 80000
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i % 3
+	}
+
+	want := append([]int(nil), s...)
+	sort.Ints(want)
+
+	start := time.Now()
+	SortFunc(s, intCmp)
+	elapsed := time.Since(start)
+
+	for i := range s {
+		if s[i] != want[i] {
+			t.Fatalf("mismatch at %d: got %v, want %v", i, s[i], want[i])
+		}
+	}
+
+	if elapsed > 500*time.Millisecond {
+		t.Fatalf("periodic low-cardinality sort took %v, want well under 500ms (suspect O(n^2) regression)", elapsed)
+	}
+}
+
+func TestSortStableFunc(t *testing.T) {
+	type kv struct{ key, seq int }
+
+	r := rand.New(rand.NewSource(3))
+
+	for trial := 0; trial < 100; trial++ {
+		n := r.Intn(300)
+		s := make([]kv, n)
+		for i := range s {
+			s[i] = kv{key: r.Intn(10), seq: i}
+		}
+
+		want := append([]kv(nil), s...)
+		sort.SliceStable(want, func(i, j int) bool { return want[i].key < want[j].key })
+
+		SortStableFunc(s, func(a, b kv) int { return a.key - b.key })
+
+		for i := range s {
+			if s[i] != want[i] {
+				t.Fatalf("trial %d: mismatch at %d: got %v, want %v", trial, i, s, want)
+			}
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	s := []int{5, 3, 4, 1, 2}
+	Sort(s)
+	want := []int{1, 2, 3, 4, 5}
+	for i := range s {
+		if s[i] != want[i] {
+			t.Fatalf("Sort() = %v, want %v", s, want)
+		}
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	if !IsSorted([]int{1, 2, 2, 3}) {
+		t.Error("IsSorted should report true for a sorted slice")
+	}
+	if IsSorted([]int{1, 3, 2}) {
+		t.Error("IsSorted should report false for an unsorted slice")
+	}
+}
+
+func TestBinarySearchFunc(t *testing.T) {
+	s := []int{1, 3, 3, 5, 7, 9}
+
+	cases := []struct {
+		target int
+		idx    int
+		found  bool
+	}{
+		{0, 0, false},
+		{1, 0, true},
+		{3, 1, true},
+		{4, 3, false},
+		{9, 5, true},
+		{10, 6, false},
+	}
+
+	for _, c := range cases {
+		idx, found := BinarySearchFunc(s, c.target, intCmp)
+		if idx != c.idx || found != c.found {
+			t.Errorf("BinarySearchFunc(%v, %d) = (%d, %v), want (%d, %v)", s, c.target, idx, found, c.idx, c.found)
+		}
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	s := []int{2, 4, 6, 8}
+	idx, found := BinarySearch(s, 6)
+	if idx != 2 || !found {
+		t.Errorf("BinarySearch(%v, 6) = (%d, %v), want (2, true)", s, idx, found)
+	}
+}
+
+func TestFindMedianCopyAgreesWithFindMedian(t *testing.T) {
+	cases := [][]int{
+		{5},
+		{2, 1},
+		{3, 1, 2},
+		{4, 1, 3, 2},
+		{5, 3, 1, 4, 2},
+		{1, 1, 1, 1},
+		{7, 7, 1, 3, 7, 3},
+		{9, 8, 7, 6, 5, 4, 3, 2, 1},
+	}
+
+	for _, data := range cases {
+		want := FindMedian(data)
+		got := FindMedianCopy(data)
+		if got != want {
+			t.Errorf("FindMedianCopy(%v) = %d, want %d (FindMedian's answer)", data, got, want)
+		}
+	}
+}
+
+func TestFindMedianCopyDoesNotMutateInput(t *testing.T) {
+	data := []int{5, 3, 1, 4, 2}
+	original := append([]int(nil), data...)
+
+	FindMedianCopy(data)
+
+	if !reflect.DeepEqual(data, original) {
+		t.Errorf("FindMedianCopy mutated its input: got %v, want %v", data, original)
+	}
+}